@@ -10,8 +10,32 @@ func handler(w http.ResponseWriter, r *http.Request) {
 	fmt.Fprintf(w, "Hello from the Go http server!")
 }
 
+func apiHandler(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		fmt.Fprintf(w, "list items")
+	case http.MethodPost:
+		fmt.Fprintf(w, "create item")
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// statusHandler implements http.Handler directly, rather than being
+// registered as a plain function, to exercise the http.Handle idiom.
+type statusHandler struct{}
+
+func (statusHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	fmt.Fprintf(w, "ok")
+}
+
 func main() {
 	http.HandleFunc("/", handler)
+	http.HandleFunc("/api/items", apiHandler)
+	http.Handle("/status", statusHandler{})
+	http.Handle("/static/", http.StripPrefix("/static/", http.FileServer(http.Dir("./static"))))
+	http.Handle("/old-path", http.RedirectHandler("/", http.StatusMovedPermanently))
+
 	fmt.Println("Starting server on :8080")
 	http.ListenAndServe(":8080", nil)
-} 
\ No newline at end of file
+}
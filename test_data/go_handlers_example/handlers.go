@@ -0,0 +1,18 @@
+// test_data/go_handlers_example/handlers.go
+package main
+
+import (
+	"fmt"
+	"net/http"
+)
+
+func homeHandler(w http.ResponseWriter, r *http.Request) {
+	fmt.Fprintf(w, "welcome")
+}
+
+// healthHandler matches the http.Handler signature but is never passed
+// to HandleFunc/Handle anywhere in this package, exercising the
+// "unregistered handler" lint case.
+func healthHandler(w http.ResponseWriter, r *http.Request) {
+	fmt.Fprintf(w, "ok")
+}
@@ -0,0 +1,23 @@
+// test_data/go_handlers_example/main.go
+//
+// Handlers live in handlers.go; main.go only wires routes. This fixture
+// is intentionally invalid Go: adminHandler is registered here but never
+// declared anywhere in the package, so `sagitta lint http` can report it
+// as "registered but undefined". healthHandler in handlers.go covers the
+// opposite case, a handler that is defined but never mounted. Route and
+// handler extraction is regex-based and doesn't require the package to
+// compile, so both cases are still detected correctly.
+package main
+
+import (
+	"fmt"
+	"net/http"
+)
+
+func main() {
+	http.HandleFunc("/", homeHandler)
+	http.HandleFunc("/admin", adminHandler)
+
+	fmt.Println("Starting server on :8080")
+	http.ListenAndServe(":8080", nil)
+}